@@ -25,6 +25,10 @@ type ClientCfg struct {
 	// More connections allow for more messages per second,
 	// but increases the number of file-handles used.
 	ConnectionsPerPeer int
+	// AllowUnicodeNames of mailboxes sent to, which must be set to
+	// address mailboxes created by a server that also allows them,
+	// see ServerCfg.AllowUnicodeNames.
+	AllowUnicodeNames bool
 	// Logger optionally used for logging, default is to not log.
 	Logger Logger
 }
@@ -53,6 +57,13 @@ type ServerCfg struct {
 	Timeout time.Duration
 	// LeaseDuration for data in etcd.
 	LeaseDuration time.Duration
+	// AllowUnicodeNames for actors, actor types, and mailboxes, so
+	// that letters and digits of any script, and dots, are allowed
+	// in addition to the default set [a-zA-Z0-9-_]. Whitespace is
+	// never allowed. Names are compared byte-for-byte, they are not
+	// unicode normalized. The namespace must always use the default
+	// set.
+	AllowUnicodeNames bool
 	// Logger optionally used for logging, default is to not log.
 	Logger Logger
 }
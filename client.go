@@ -29,6 +29,24 @@ func Register(v interface{}) error {
 	return codec.Register(v)
 }
 
+// RegisterMessages so they may be sent and received, the
+// same as calling Register on each. If any of the values
+// cannot be registered, none of them are registered.
+//
+// For example:
+//     RegisterMessages(MyMsg{}, MyOtherMsg{})
+//
+func RegisterMessages(vs ...interface{}) error {
+	return codec.RegisterAll(vs...)
+}
+
+// RegisteredMessages returns the type names of all registered
+// messages, sorted. Useful for debugging peers that disagree
+// about which messages can be sent.
+func RegisteredMessages() []string {
+	return codec.Registered()
+}
+
 //clientAndConnPool is a pool of clientAndConn
 type clientAndConnPool struct {
 	// The 'id' is used in a kind of CAS when
@@ -150,8 +168,34 @@ func (c *Client) Request(timeout time.Duration, receiver string, msg interface{}
 // RequestC (request) a response for the given message. The context can be
 // used to control cancelation or timeouts.
 func (c *Client) RequestC(ctx context.Context, receiver string, msg interface{}) (interface{}, error) {
+	return c.request(ctx, receiver, msg, false)
+}
+
+// RequestWait (request) a response for the given message. Unlike Request,
+// if the receiver is busy the request is retried until the timeout,
+// after which ErrContextFinished is returned. Other errors are retried
+// at most three times, one second apart, just like Request.
+func (c *Client) RequestWait(timeout time.Duration, receiver string, msg interface{}) (interface{}, error) {
+	timeoutC, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return c.RequestWaitC(timeoutC, receiver, msg)
+}
+
+// RequestWaitC (request) a response for the given message. Unlike RequestC,
+// if the receiver is busy the request is retried until the context is
+// done, after which ErrContextFinished is returned. This bounds the
+// latency of the caller without failing instantly on a slow receiver.
+// Other errors are retried at most three times, one second apart,
+// just like RequestC.
+func (c *Client) RequestWaitC(ctx context.Context, receiver string, msg interface{}) (interface{}, error) {
+	return c.request(ctx, receiver, msg, true)
+}
+
+// request a response for the given message, when waitBusy is true a
+// busy receiver is retried until the context is done.
+func (c *Client) request(ctx context.Context, receiver string, msg interface{}, waitBusy bool) (interface{}, error) {
 	// Namespaced receiver name.
-	nsReceiver, err := namespaceName(Mailboxes, c.cfg.Namespace, receiver)
+	nsReceiver, err := namespaceNameWith(nameValidator(c.cfg.AllowUnicodeNames), Mailboxes, c.cfg.Namespace, receiver)
 	if err != nil {
 		return nil, err
 	}
@@ -169,7 +213,9 @@ func (c *Client) RequestC(ctx context.Context, receiver string, msg interface{})
 	}
 
 	var res *Delivery
-	retry.X(3, 1*time.Second, func() bool {
+	var busy, wasBusy bool
+	send := func() bool {
+		busy = false
 		var client WireClient
 		var clientID int64
 		client, clientID, err = c.getWireClient(ctx, nsReceiver)
@@ -252,6 +298,8 @@ func (c *Client) RequestC(ctx context.Context, receiver string, msg interface{})
 			// was at capacity. Also, the reciever definitely
 			// did NOT get the message, so there is no risk
 			// of duplication if the request is tried again.
+			busy = true
+			wasBusy = true
 			select {
 			case <-ctx.Done():
 				return false
@@ -260,7 +308,49 @@ func (c *Client) RequestC(ctx context.Context, receiver string, msg interface{})
 			}
 		}
 		return false
-	})
+	}
+	if waitBusy {
+		// Keep sending until the receiver accepts or the
+		// context is done. A busy receiver is retried with
+		// a growing backoff, while other errors keep the
+		// limit of three tries, one second apart, used by
+		// retry below.
+		const maxBusyBackoff = 1 * time.Second
+		const failureBackoff = 1 * time.Second
+		const maxFailures = 3
+		busyBackoff := 10 * time.Millisecond
+		failures := 0
+	wait:
+		for send() {
+			delay := busyBackoff
+			if busy {
+				busyBackoff *= 2
+				if busyBackoff > maxBusyBackoff {
+					busyBackoff = maxBusyBackoff
+				}
+			} else {
+				failures++
+				if failures >= maxFailures {
+					break
+				}
+				delay = failureBackoff
+			}
+			select {
+			case <-ctx.Done():
+				break wait
+			case <-time.After(delay):
+			}
+		}
+		// If the receiver was busy at any point and the
+		// context finished, the deadline could have been
+		// hit while busy or mid-send, either way report
+		// that the context finished.
+		if err != nil && wasBusy && ctx.Err() != nil {
+			err = ErrContextFinished
+		}
+	} else {
+		retry.X(3, 1*time.Second, send)
+	}
 	if err != nil {
 		return nil, err
 	}
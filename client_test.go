@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/coreos/etcd/clientv3"
+	"github.com/lytics/grid/codec"
 	"github.com/lytics/grid/testetcd"
 )
 
@@ -38,6 +39,45 @@ func (a *busyActor) Act(c context.Context) {
 	<-c.Done()
 }
 
+type slowActor struct {
+	ready  chan bool
+	delay  time.Duration
+	server *Server
+}
+
+func (a *slowActor) Act(c context.Context) {
+	name, err := ContextActorName(c)
+	if err != nil {
+		return
+	}
+
+	mailbox, err := NewMailbox(a.server, name, 0)
+	if err != nil {
+		return
+	}
+	defer mailbox.Close()
+
+	// Be busy for a while before
+	// listening to the mailbox.
+	a.ready <- true
+	select {
+	case <-c.Done():
+		return
+	case <-time.After(a.delay):
+	}
+	for {
+		select {
+		case <-c.Done():
+			return
+		case req, ok := <-mailbox.C:
+			if !ok {
+				return
+			}
+			req.Respond(req.Msg())
+		}
+	}
+}
+
 type echoActor struct {
 	ready  chan bool
 	server *Server
@@ -490,6 +530,147 @@ func TestClientWithBusyReceiver(t *testing.T) {
 	}
 }
 
+func TestClientRequestWaitWithBusyReceiver(t *testing.T) {
+	const timeout = 2 * time.Second
+	expected := &EchoMsg{"testing 1, 2, 3"}
+
+	// Bootstrap.
+	etcd, server, client := bootstrapClientTest(t)
+	defer etcd.Close()
+	defer server.Stop()
+	defer client.Close()
+
+	// Set client stats.
+	client.cs = newClientStats()
+
+	// Create busy actor.
+	a := &busyActor{ready: make(chan bool)}
+
+	server.RegisterDef("busy", func(_ []byte) (Actor, error) { return a, nil })
+
+	// Set server on busy actor.
+	a.server = server
+
+	// Discover some peers.
+	peers, err := client.Query(timeout, Peers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(peers) != 1 {
+		t.Fatal("expected 1 peer")
+	}
+
+	// Start the busy actor on the first peer.
+	res, err := client.Request(timeout, peers[0].Name(), NewActorStart("busy"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res == nil {
+		t.Fatal("expected response")
+	}
+
+	// Wait for busy actor to start.
+	<-a.ready
+
+	// Make a request to busy actor, it never
+	// listens so the deadline is exceeded.
+	t0 := time.Now()
+	res, err = client.RequestWait(timeout, "busy", expected)
+	if err != ErrContextFinished {
+		t.Fatal(err)
+	}
+	if res != nil {
+		t.Fatal("expected nil response")
+	}
+	if time.Since(t0) < timeout {
+		t.Fatal("expected request to wait for the timeout")
+	}
+
+	if v := client.cs.counters[numErrReceiverBusy]; v == 0 {
+		t.Fatal("expected non-zero error count")
+	}
+}
+
+func TestClientRequestWaitWithSlowReceiver(t *testing.T) {
+	const timeout = 4 * time.Second
+	expected := &EchoMsg{"testing 1, 2, 3"}
+
+	// Bootstrap.
+	etcd, server, client := bootstrapClientTest(t)
+	defer etcd.Close()
+	defer server.Stop()
+	defer client.Close()
+
+	// Set client stats.
+	client.cs = newClientStats()
+
+	// Create slow actor, which is busy for
+	// less time than the request timeout.
+	a := &slowActor{ready: make(chan bool), delay: 1 * time.Second}
+
+	server.RegisterDef("slow", func(_ []byte) (Actor, error) { return a, nil })
+
+	// Set server on slow actor.
+	a.server = server
+
+	// Discover some peers.
+	peers, err := client.Query(timeout, Peers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(peers) != 1 {
+		t.Fatal("expected 1 peer")
+	}
+
+	// Start the slow actor on the first peer.
+	res, err := client.Request(timeout, peers[0].Name(), NewActorStart("slow"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res == nil {
+		t.Fatal("expected response")
+	}
+
+	// Wait for slow actor to start.
+	<-a.ready
+
+	// Make a request to slow actor, it starts
+	// listening before the deadline.
+	res, err = client.RequestWait(timeout, "slow", expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	switch res := res.(type) {
+	case *EchoMsg:
+		if res.Msg != expected.Msg {
+			t.Fatalf("expected: %v, received: %v", expected, res)
+		}
+	default:
+		t.Fatalf("expected type: *EchoMsg, received type: %T", res)
+	}
+
+	if v := client.cs.counters[numErrReceiverBusy]; v == 0 {
+		t.Fatal("expected non-zero error count")
+	}
+}
+
+func TestRegisterMessagesWithNonProtobuf(t *testing.T) {
+	// Delivery is a protobuf message that is
+	// never registered, since it's the wire
+	// envelope itself.
+	unexpected := codec.TypeName(Delivery{})
+
+	err := RegisterMessages(Delivery{}, "notProto")
+	if err != codec.ErrUnsupportedMessage {
+		t.Fatal("expected unsupported message error")
+	}
+	for _, name := range RegisteredMessages() {
+		if name == unexpected {
+			t.Fatal("expected no types registered, found:", name)
+		}
+	}
+}
+
 func TestClientStats(t *testing.T) {
 	cs := newClientStats()
 	cs.Inc(numGetWireClient)
@@ -519,6 +700,10 @@ func TestNilClientStats(t *testing.T) {
 }
 
 func bootstrapClientTest(t *testing.T) (*clientv3.Client, *Server, *Client) {
+	return bootstrapClientTestAllowUnicode(t, false)
+}
+
+func bootstrapClientTestAllowUnicode(t *testing.T, allowUnicode bool) (*clientv3.Client, *Server, *Client) {
 	// Namespace for test.
 	namespace := newNamespace()
 
@@ -529,7 +714,7 @@ func bootstrapClientTest(t *testing.T) (*clientv3.Client, *Server, *Client) {
 	logger := log.New(os.Stderr, namespace+": ", log.LstdFlags)
 
 	// Create the server.
-	server, err := NewServer(etcd, ServerCfg{Namespace: namespace, Logger: logger})
+	server, err := NewServer(etcd, ServerCfg{Namespace: namespace, AllowUnicodeNames: allowUnicode, Logger: logger})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -551,7 +736,7 @@ func bootstrapClientTest(t *testing.T) (*clientv3.Client, *Server, *Client) {
 	time.Sleep(2 * time.Second)
 
 	// Create a grid client.
-	client, err := NewClient(etcd, ClientCfg{Namespace: namespace, Logger: logger})
+	client, err := NewClient(etcd, ClientCfg{Namespace: namespace, AllowUnicodeNames: allowUnicode, Logger: logger})
 	if err != nil {
 		t.Fatal(err)
 	}
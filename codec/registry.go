@@ -3,6 +3,7 @@ package codec
 import (
 	"errors"
 	"reflect"
+	"sort"
 	"sync"
 
 	"github.com/golang/protobuf/proto"
@@ -25,25 +26,42 @@ var (
 // Register a type for marshalling and unmarshalling.
 // The type must currently implement proto.Message.
 func Register(v interface{}) error {
+	return RegisterAll(v)
+}
+
+// RegisterAll types for marshalling and unmarshalling.
+// If any of the types is unsupported then none of
+// them are registered.
+func RegisterAll(vs ...interface{}) error {
 	mu.Lock()
 	defer mu.Unlock()
 
-	// The value 'v' must not be registered
-	// as a pointer type, but to check if
-	// it is a proto message, the pointer
-	// type must be checked.
-	pv := reflect.New(reflect.TypeOf(v)).Interface()
-
-	_, ok := pv.(proto.Message)
-	if !ok {
-		return ErrUnsupportedMessage
+	for _, v := range vs {
+		if !isProtoMessage(v) {
+			return ErrUnsupportedMessage
+		}
+	}
+	for _, v := range vs {
+		name := TypeName(v)
+		registry[name] = v
 	}
-
-	name := TypeName(v)
-	registry[name] = v
 	return nil
 }
 
+// Registered type names, sorted. Useful for debugging
+// peers that disagree about which types can be sent.
+func Registered() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // Marshal the value into bytes. The function returns
 // the type name, the bytes, or an error.
 func Marshal(v interface{}) (string, []byte, error) {
@@ -94,6 +112,16 @@ func TypeName(v interface{}) string {
 	return pkg + "/" + name
 }
 
+// isProtoMessage returns true if a pointer to the type of
+// v implements proto.Message. The value 'v' must not be
+// registered as a pointer type, but to check if it is a
+// proto message, the pointer type must be checked.
+func isProtoMessage(v interface{}) bool {
+	pv := reflect.New(reflect.TypeOf(v)).Interface()
+	_, ok := pv.(proto.Message)
+	return ok
+}
+
 func protoMarshal(v interface{}) ([]byte, error) {
 	pb := v.(proto.Message)
 	return proto.Marshal(pb)
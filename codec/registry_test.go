@@ -54,6 +54,43 @@ func TestRegisterMarshalUnmarshal(t *testing.T) {
 	}
 }
 
+func TestRegistered(t *testing.T) {
+	const (
+		expected = "github.com/lytics/grid/codec/protomessage/Person"
+	)
+
+	err := Register(protomessage.Person{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found := false
+	for _, name := range Registered() {
+		if name == expected {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("expected registered type:", expected)
+	}
+}
+
+func TestRegisterAllWithNonProtobuf(t *testing.T) {
+	const (
+		unexpected = "github.com/lytics/grid/codec/protomessage/Person_PhoneNumber"
+	)
+
+	err := RegisterAll(protomessage.Person_PhoneNumber{}, "notProto")
+	if err != ErrUnsupportedMessage {
+		t.Fatal("expected error")
+	}
+	for _, name := range Registered() {
+		if name == unexpected {
+			t.Fatal("expected no types registered, found:", name)
+		}
+	}
+}
+
 func TestNonProtobuf(t *testing.T) {
 	notProto := "notProto"
 
@@ -86,12 +86,13 @@ func (box *Mailbox) put(req *request) error {
 // Using a mailbox requires that the process creating the mailbox also
 // started a grid Server.
 func NewMailbox(s *Server, name string, size int) (*Mailbox, error) {
-	if !isNameValid(name) {
+	isValid := nameValidator(s.cfg.AllowUnicodeNames)
+	if !isValid(name) {
 		return nil, ErrInvalidMailboxName
 	}
 
 	// Namespaced name.
-	nsName, err := namespaceName(Mailboxes, s.cfg.Namespace, name)
+	nsName, err := namespaceNameWith(isValid, Mailboxes, s.cfg.Namespace, name)
 	if err != nil {
 		return nil, err
 	}
@@ -5,19 +5,43 @@ import (
 	"regexp"
 )
 
+// Names are joined with their namespace and entity type using a
+// dot, for example "namespace.mailbox.name". A dot in the name is
+// still unambiguous, since the namespace must be in the default
+// set and the entity type is fixed, so the name is everything
+// after the known prefix. Names are compared byte-for-byte, no
+// unicode normalization is done, so the same visible name in two
+// different normal forms is two different names.
+var (
+	// validName matches ASCII letters, digits, dash,
+	// and underscore. This is the default set.
+	validName = regexp.MustCompile(`^[a-zA-Z0-9-_]+$`)
+	// validUnicodeName matches letters, marks, and digits
+	// of any script, plus dash, underscore, and dot. A mark
+	// or dot can not be first.
+	validUnicodeName = regexp.MustCompile(`^[\p{L}\p{N}_-][\p{L}\p{M}\p{N}._-]*$`)
+)
+
 // isNameValid returns true if the name matches the
 // regular expression "^[a-zA-Z0-9-_]+$".
 func isNameValid(name string) bool {
-	const validActorName = "^[a-zA-Z0-9-_]+$"
+	return validName.MatchString(name)
+}
 
-	if name == "" {
-		return false
-	}
-	if matched, err := regexp.MatchString(validActorName, name); err != nil {
-		return false
-	} else {
-		return matched
+// isUnicodeNameValid returns true if the name matches the
+// regular expression "^[\p{L}\p{N}_-][\p{L}\p{M}\p{N}._-]*$".
+func isUnicodeNameValid(name string) bool {
+	return validUnicodeName.MatchString(name)
+}
+
+// nameValidator for actors, actor types, and mailboxes. When
+// allowUnicode is true, letters and digits of any script, and
+// dots, are valid, otherwise only the default set is.
+func nameValidator(allowUnicode bool) func(string) bool {
+	if allowUnicode {
+		return isUnicodeNameValid
 	}
+	return isNameValid
 }
 
 func stripNamespace(t EntityType, namespace, fullname string) (string, error) {
@@ -28,8 +52,16 @@ func stripNamespace(t EntityType, namespace, fullname string) (string, error) {
 	return fullname[plen:], nil
 }
 
+// namespaceName joins the namespace, entity type, and name. The name
+// must be in the default set.
 func namespaceName(t EntityType, namespace, name string) (string, error) {
-	if !isNameValid(name) {
+	return namespaceNameWith(isNameValid, t, namespace, name)
+}
+
+// namespaceNameWith joins the namespace, entity type, and name. The
+// name must be accepted by valid.
+func namespaceNameWith(valid func(string) bool, t EntityType, namespace, name string) (string, error) {
+	if !valid(name) {
 		return "", ErrInvalidName
 	}
 	if !isNameValid(namespace) {
@@ -83,3 +83,107 @@ func TestNamespacePrefixInvalidNamespace(t *testing.T) {
 		t.Fatal("expected invalid namespace error")
 	}
 }
+
+func TestIsNameValidTable(t *testing.T) {
+	tests := []struct {
+		name    string
+		valid   bool
+		unicode bool
+	}{
+		{name: "worker", valid: true, unicode: true},
+		{name: "worker-1", valid: true, unicode: true},
+		{name: "worker_1", valid: true, unicode: true},
+		{name: "WORKER", valid: true, unicode: true},
+		{name: "6ba7b810-9dad-11d1-80b4-00c04fd430c8", valid: true, unicode: true},
+		{name: "arbeiter-größe", valid: false, unicode: true},
+		{name: "работник", valid: false, unicode: true},
+		{name: "作業者-1", valid: false, unicode: true},
+		{name: "कार्यकर्ता", valid: false, unicode: true},
+		{name: "", valid: false, unicode: false},
+		{name: "worker.1", valid: false, unicode: true},
+		{name: "com.example.worker", valid: false, unicode: true},
+		{name: ".worker", valid: false, unicode: false},
+		{name: "worker 1", valid: false, unicode: false},
+		{name: "worker\t1", valid: false, unicode: false},
+		{name: "worker\n", valid: false, unicode: false},
+		{name: "worker\u00a01", valid: false, unicode: false},
+		{name: "\u0301", valid: false, unicode: false},
+		{name: "\u0301worker", valid: false, unicode: false},
+		{name: "worker/1", valid: false, unicode: false},
+		{name: "worker!", valid: false, unicode: false},
+		{name: "worker😀", valid: false, unicode: false},
+	}
+	for _, tt := range tests {
+		if v := isNameValid(tt.name); v != tt.valid {
+			t.Fatalf("name: %q, expected valid: %v, got: %v", tt.name, tt.valid, v)
+		}
+		if v := isUnicodeNameValid(tt.name); v != tt.unicode {
+			t.Fatalf("name: %q, expected unicode valid: %v, got: %v", tt.name, tt.unicode, v)
+		}
+	}
+}
+
+func TestNamespaceNameUnicodeName(t *testing.T) {
+	_, err := namespaceName(Mailboxes, "ns", "работник")
+	if err != ErrInvalidName {
+		t.Fatal("expected invalid name error")
+	}
+
+	res, err := namespaceNameWith(isUnicodeNameValid, Mailboxes, "ns", "работник")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != "ns.mailbox.работник" {
+		t.Fatalf("unexpected name: %v", res)
+	}
+}
+
+func TestNamespaceNameUnicodeNameNotNormalized(t *testing.T) {
+	// The same visible name "größe", composed (NFC)
+	// and decomposed (NFD), gives different names,
+	// since names are compared byte-for-byte.
+	nfc, err := namespaceNameWith(isUnicodeNameValid, Mailboxes, "ns", "gr\u00f6\u00dfe")
+	if err != nil {
+		t.Fatal(err)
+	}
+	nfd, err := namespaceNameWith(isUnicodeNameValid, Mailboxes, "ns", "gro\u0308\u00dfe")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nfc == nfd {
+		t.Fatalf("expected different names, got: %q and %q", nfc, nfd)
+	}
+}
+
+func TestNamespaceNameDottedName(t *testing.T) {
+	// Dots are only allowed by the unicode rules.
+	_, err := namespaceName(Mailboxes, "ns", "a.b")
+	if err != ErrInvalidName {
+		t.Fatal("expected invalid name error")
+	}
+
+	// The namespace is in the default set, which has
+	// no dots, and the entity type is fixed, so the
+	// name "a.b" is the only way to read the result.
+	res, err := namespaceNameWith(isUnicodeNameValid, Mailboxes, "ns", "a.b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != "ns.mailbox.a.b" {
+		t.Fatalf("unexpected name: %v", res)
+	}
+	name, err := stripNamespace(Mailboxes, "ns", res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "a.b" {
+		t.Fatalf("expected name: a.b, got: %v", name)
+	}
+}
+
+func TestNamespaceNameUnicodeNamespace(t *testing.T) {
+	_, err := namespaceName(Mailboxes, "пространство", "valid")
+	if err != ErrInvalidNamespace {
+		t.Fatal("expected invalid namespace error")
+	}
+}
@@ -410,14 +410,15 @@ func (s *Server) startActorC(c context.Context, start *ActorStart) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	if !isNameValid(start.Type) {
+	isValid := nameValidator(s.cfg.AllowUnicodeNames)
+	if !isValid(start.Type) {
 		return ErrInvalidActorType
 	}
-	if !isNameValid(start.Name) {
+	if !isValid(start.Name) {
 		return ErrInvalidActorName
 	}
 
-	nsName, err := namespaceName(Actors, s.cfg.Namespace, start.Name)
+	nsName, err := namespaceNameWith(isValid, Actors, s.cfg.Namespace, start.Name)
 	if err != nil {
 		return err
 	}
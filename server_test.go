@@ -209,3 +209,116 @@ func TestServerStartThenEtcdStop(t *testing.T) {
 		}
 	}
 }
+
+func TestServerWithUnicodeNamesNotAllowed(t *testing.T) {
+	const timeout = 2 * time.Second
+
+	// Bootstrap.
+	etcd, server, client := bootstrapClientTestAllowUnicode(t, false)
+	defer etcd.Close()
+	defer server.Stop()
+	defer client.Close()
+
+	_, err := NewMailbox(server, "работник", 1)
+	if err != ErrInvalidMailboxName {
+		t.Fatal("expected invalid mailbox name error")
+	}
+
+	_, err = NewMailbox(server, "com.example.worker", 1)
+	if err != ErrInvalidMailboxName {
+		t.Fatal("expected invalid mailbox name error")
+	}
+
+	err = server.startActorC(context.Background(), &ActorStart{Type: "echo", Name: "работник"})
+	if err != ErrInvalidActorName {
+		t.Fatal("expected invalid actor name error")
+	}
+
+	err = server.startActorC(context.Background(), &ActorStart{Type: "работник", Name: "echo"})
+	if err != ErrInvalidActorType {
+		t.Fatal("expected invalid actor type error")
+	}
+
+	_, err = client.Request(timeout, "работник", &EchoMsg{"testing 1, 2, 3"})
+	if err != ErrInvalidName {
+		t.Fatal("expected invalid name error")
+	}
+}
+
+func TestServerWithUnicodeNamesAllowed(t *testing.T) {
+	const timeout = 2 * time.Second
+	expected := &EchoMsg{"testing 1, 2, 3"}
+
+	// Bootstrap.
+	etcd, server, client := bootstrapClientTestAllowUnicode(t, true)
+	defer etcd.Close()
+	defer server.Stop()
+	defer client.Close()
+
+	mailbox, err := NewMailbox(server, "größe", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mailbox.Close()
+
+	// A dotted name must be found by query
+	// with the same name it was created with.
+	dotted, err := NewMailbox(server, "com.example.worker", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mailboxes, err := client.Query(timeout, Mailboxes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, m := range mailboxes {
+		if m.Name() == dotted.Name() {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("expected to find mailbox:", dotted.Name())
+	}
+	dotted.Close()
+
+	// Create echo actor, with a unicode type.
+	a := &echoActor{ready: make(chan bool), server: server}
+	server.RegisterDef("работник", func(_ []byte) (Actor, error) { return a, nil })
+
+	// Discover some peers.
+	peers, err := client.Query(timeout, Peers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(peers) != 1 {
+		t.Fatal("expected 1 peer")
+	}
+
+	// Start the echo actor, with a unicode name, on the first peer.
+	res, err := client.Request(timeout, peers[0].Name(), NewActorStart("работник"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res == nil {
+		t.Fatal("expected response")
+	}
+
+	// Wait for echo actor to start.
+	<-a.ready
+
+	// Make a request to echo actor, its mailbox
+	// has the same unicode name as the actor.
+	res, err = client.Request(timeout, "работник", expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	switch res := res.(type) {
+	case *EchoMsg:
+		if res.Msg != expected.Msg {
+			t.Fatalf("expected: %v, received: %v", expected, res)
+		}
+	default:
+		t.Fatalf("expected type: *EchoMsg, received type: %T", res)
+	}
+}